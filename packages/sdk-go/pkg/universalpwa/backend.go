@@ -0,0 +1,158 @@
+package universalpwa
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Mode selects how Client talks to the UniversalPWA engine.
+type Mode string
+
+const (
+	// ModeRemote always calls the HTTP API at Config.APIEndpoint.
+	ModeRemote Mode = "remote"
+	// ModeLocal performs framework detection and generation in-process,
+	// without requiring a running API server.
+	ModeLocal Mode = "local"
+	// ModeAuto prefers the remote API and falls back to ModeLocal when the
+	// endpoint is unreachable.
+	ModeAuto Mode = "auto"
+)
+
+// ScanRequest is the input to Backend.Scan.
+type ScanRequest struct {
+	ProjectRoot       string
+	AutoDetectBackend bool
+}
+
+// GenerationRequest is the input to Backend.Generate.
+type GenerationRequest struct {
+	Config map[string]interface{}
+}
+
+// ValidationRequest is the input to Backend.Validate.
+type ValidationRequest struct {
+	ProjectRoot string
+}
+
+// Backend performs the actual scan/generate/validate work, either by
+// calling the UniversalPWA HTTP API or by running the equivalent logic
+// in-process.
+type Backend interface {
+	Scan(ctx context.Context, req ScanRequest) (ScanResult, error)
+	Generate(ctx context.Context, req GenerationRequest) (GenerationResult, error)
+	Validate(ctx context.Context, req ValidationRequest) (ValidationResult, error)
+}
+
+// selectBackend resolves Config.Mode to a concrete Backend. For ModeAuto it
+// returns an autoBackend, which defers the remote-reachability probe to each
+// call instead of blocking construction on network I/O.
+func selectBackend(config *Config, o *clientOptions) Backend {
+	switch config.Mode {
+	case ModeLocal:
+		return newLocalBackend(config)
+	case ModeRemote:
+		return newHTTPBackend(config, o)
+	default: // ModeAuto and unset
+		return newAutoBackend(config, o)
+	}
+}
+
+// autoBackendRecheckInterval bounds how often autoBackend re-probes the
+// remote endpoint once a reachability decision has been cached, so a
+// long-lived Client notices an endpoint coming up or going down without
+// probing on every single call.
+const autoBackendRecheckInterval = 30 * time.Second
+
+// autoBackend implements Backend for ModeAuto by picking between a remote
+// and a local Backend on first use (and periodically thereafter), rather
+// than deciding once at construction time. That keeps NewClient itself
+// non-blocking, which matters for offline/CI environments where probing
+// APIEndpoint would otherwise add up to 2s to every Client construction.
+type autoBackend struct {
+	config     *Config
+	httpClient *http.Client
+	remote     Backend
+	local      Backend
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	useRemote bool
+}
+
+func newAutoBackend(config *Config, o *clientOptions) *autoBackend {
+	return &autoBackend{
+		config:     config,
+		httpClient: o.httpClient,
+		remote:     newHTTPBackend(config, o),
+		local:      newLocalBackend(config),
+	}
+}
+
+// resolve returns the Backend this call should use, probing
+// config.APIEndpoint at most once per autoBackendRecheckInterval.
+func (b *autoBackend) resolve() Backend {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if time.Since(b.checkedAt) > autoBackendRecheckInterval {
+		b.useRemote = isEndpointReachable(b.config.APIEndpoint, b.httpClient)
+		b.checkedAt = time.Now()
+	}
+	if b.useRemote {
+		return b.remote
+	}
+	return b.local
+}
+
+// kind reports which concrete Backend the most recent resolve() picked, for
+// ScanReport.Backend.
+func (b *autoBackend) kind() DetectedBackend {
+	if _, ok := b.resolve().(*localBackend); ok {
+		return DetectedBackendLocal
+	}
+	return DetectedBackendRemote
+}
+
+func (b *autoBackend) Scan(ctx context.Context, req ScanRequest) (ScanResult, error) {
+	return b.resolve().Scan(ctx, req)
+}
+
+func (b *autoBackend) Generate(ctx context.Context, req GenerationRequest) (GenerationResult, error) {
+	return b.resolve().Generate(ctx, req)
+}
+
+func (b *autoBackend) Validate(ctx context.Context, req ValidationRequest) (ValidationResult, error) {
+	return b.resolve().Validate(ctx, req)
+}
+
+// GenerateStream implements streamer so Client.GenerateStream can use
+// autoBackend like any other streaming-capable Backend, delegating to
+// whichever concrete Backend resolve() currently picks.
+func (b *autoBackend) GenerateStream(ctx context.Context, req GenerationRequest) (<-chan ProgressEvent, <-chan error) {
+	resolved := b.resolve()
+	if s, ok := resolved.(streamer); ok {
+		return s.GenerateStream(ctx, req)
+	}
+	return generateOnce(ctx, resolved, req)
+}
+
+// isEndpointReachable probes the API endpoint with a short-lived request so
+// ModeAuto can decide quickly whether to fall back to the local backend.
+func isEndpointReachable(endpoint string, httpClient *http.Client) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, endpoint, nil)
+	if err != nil {
+		return false
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return true
+}
@@ -0,0 +1,230 @@
+package universalpwa
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/time/rate"
+)
+
+// httpBackend implements Backend by calling the UniversalPWA HTTP API.
+type httpBackend struct {
+	endpoint     string
+	httpClient   *http.Client
+	limiter      *rate.Limiter
+	retryPolicy  RetryPolicy
+	logger       Logger
+	strictSchema bool
+}
+
+func newHTTPBackend(config *Config, o *clientOptions) *httpBackend {
+	return &httpBackend{
+		endpoint:     config.APIEndpoint,
+		httpClient:   o.httpClient,
+		limiter:      o.limiter,
+		retryPolicy:  o.retryPolicy,
+		logger:       o.logger,
+		strictSchema: config.StrictSchema,
+	}
+}
+
+const (
+	scanResponseSchema     = "scan_response.schema.json"
+	generateResponseSchema = "generate_response.schema.json"
+	validateResponseSchema = "validate_response.schema.json"
+)
+
+func (b *httpBackend) Scan(ctx context.Context, req ScanRequest) (ScanResult, error) {
+	var result ScanResult
+	err := b.post(ctx, "/api/scan", scanResponseSchema, map[string]interface{}{
+		"projectRoot":       req.ProjectRoot,
+		"autoDetectBackend": req.AutoDetectBackend,
+	}, &result)
+	return result, err
+}
+
+func (b *httpBackend) Generate(ctx context.Context, req GenerationRequest) (GenerationResult, error) {
+	var result GenerationResult
+	err := b.post(ctx, "/api/generate", generateResponseSchema, map[string]interface{}{"config": req.Config}, &result)
+	return result, err
+}
+
+func (b *httpBackend) Validate(ctx context.Context, req ValidationRequest) (ValidationResult, error) {
+	var result ValidationResult
+	err := b.post(ctx, "/api/validate", validateResponseSchema, map[string]interface{}{"projectRoot": req.ProjectRoot}, &result)
+	return result, err
+}
+
+// GenerateStream streams generation progress from /api/generate?stream=1,
+// which responds with newline-delimited JSON or Server-Sent Events frames.
+func (b *httpBackend) GenerateStream(ctx context.Context, req GenerationRequest) (<-chan ProgressEvent, <-chan error) {
+	events := make(chan ProgressEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		body, err := json.Marshal(map[string]interface{}{"config": req.Config})
+		if err != nil {
+			errs <- fmt.Errorf("failed to marshal request: %w", err)
+			return
+		}
+
+		if b.limiter != nil {
+			if err := b.limiter.Wait(ctx); err != nil {
+				errs <- fmt.Errorf("rate limiter wait: %w", err)
+				return
+			}
+		}
+
+		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, b.endpoint+"/api/generate?stream=1", bytes.NewReader(body))
+		if err != nil {
+			errs <- fmt.Errorf("failed to create request: %w", err)
+			return
+		}
+		httpReq.Header.Set("Content-Type", "application/json")
+		httpReq.Header.Set("Accept", "application/x-ndjson, text/event-stream")
+
+		resp, err := b.httpClient.Do(httpReq)
+		if err != nil {
+			errs <- fmt.Errorf("request failed: %w", err)
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			respBody, _ := io.ReadAll(resp.Body)
+			errs <- fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(respBody))
+			return
+		}
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			line = strings.TrimPrefix(line, "data:")
+			line = strings.TrimSpace(line)
+			if line == "" || line == "[DONE]" {
+				continue
+			}
+
+			var event ProgressEvent
+			if err := json.Unmarshal([]byte(line), &event); err != nil {
+				b.logf("skipping malformed progress event: %v", err)
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			errs <- fmt.Errorf("reading progress stream: %w", err)
+		}
+	}()
+
+	return events, errs
+}
+
+func (b *httpBackend) post(ctx context.Context, path, schemaName string, data interface{}, result interface{}) error {
+	url := b.endpoint + path
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= b.retryPolicy.MaxRetries; attempt++ {
+		if attempt > 0 {
+			b.logf("retrying %s (attempt %d/%d): %v", path, attempt, b.retryPolicy.MaxRetries, lastErr)
+		}
+
+		if b.limiter != nil {
+			if err := b.limiter.Wait(ctx); err != nil {
+				return fmt.Errorf("rate limiter wait: %w", err)
+			}
+		}
+
+		resp, err := b.doPost(ctx, url, body)
+		if err != nil {
+			lastErr = err
+			if attempt < b.retryPolicy.MaxRetries {
+				if sleepErr := sleepCtx(ctx, b.retryPolicy.backoff(attempt, "")); sleepErr != nil {
+					return sleepErr
+				}
+				continue
+			}
+			return lastErr
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			lastErr = fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(respBody))
+			if b.retryPolicy.isRetryable(resp.StatusCode) && attempt < b.retryPolicy.MaxRetries {
+				if sleepErr := sleepCtx(ctx, b.retryPolicy.backoff(attempt, resp.Header.Get("Retry-After"))); sleepErr != nil {
+					return sleepErr
+				}
+				continue
+			}
+			return lastErr
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
+		}
+
+		if b.strictSchema {
+			var raw map[string]interface{}
+			if err := json.Unmarshal(respBody, &raw); err != nil {
+				return fmt.Errorf("failed to decode response: %w", err)
+			}
+			if err := validateSchema(schemaName, raw); err != nil {
+				return err
+			}
+		}
+
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		return nil
+	}
+
+	return lastErr
+}
+
+// doPost issues a single attempt of the request.
+func (b *httpBackend) doPost(ctx context.Context, url string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (b *httpBackend) logf(format string, args ...interface{}) {
+	if b.logger != nil {
+		b.logger.Printf(format, args...)
+	}
+}
@@ -0,0 +1,161 @@
+package universalpwa
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// localBackend implements Backend by running framework detection and
+// manifest/service-worker/icon generation directly in-process, without a
+// running UniversalPWA API server.
+type localBackend struct {
+	projectRoot string
+}
+
+func newLocalBackend(config *Config) *localBackend {
+	return &localBackend{projectRoot: config.ProjectRoot}
+}
+
+func (b *localBackend) Scan(ctx context.Context, req ScanRequest) (ScanResult, error) {
+	detected, err := DetectFramework(req.ProjectRoot)
+	if err != nil {
+		return nil, fmt.Errorf("detect framework: %w", err)
+	}
+
+	return ScanResult{
+		"framework":  string(detected.Framework),
+		"version":    detected.Version,
+		"confidence": detected.Confidence,
+		"features":   detected.Features,
+	}, nil
+}
+
+func (b *localBackend) Generate(ctx context.Context, req GenerationRequest) (GenerationResult, error) {
+	return b.generate(ctx, req, nil)
+}
+
+// GenerateStream runs Generate on a goroutine, reporting progress for each
+// stage (detect, manifest, service worker, icons). The detect event is sent
+// as detection starts; the others are sent as each stage completes.
+func (b *localBackend) GenerateStream(ctx context.Context, req GenerationRequest) (<-chan ProgressEvent, <-chan error) {
+	events := make(chan ProgressEvent, 4)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		_, err := b.generate(ctx, req, func(event ProgressEvent) {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+			}
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return events, errs
+}
+
+const localGenerateStageCount = 4
+
+func (b *localBackend) generate(ctx context.Context, req GenerationRequest, onProgress func(ProgressEvent)) (GenerationResult, error) {
+	emit := func(stage string, current int, message string, artifact *ArtifactInfo) {
+		if onProgress != nil {
+			onProgress(ProgressEvent{Stage: stage, Current: current, Total: localGenerateStageCount, Message: message, Artifact: artifact})
+		}
+	}
+
+	projectRoot, _ := req.Config["projectRoot"].(string)
+	if projectRoot == "" {
+		projectRoot = b.projectRoot
+	}
+
+	emit("detect", 1, "detecting framework", nil)
+	detected, err := DetectFramework(projectRoot)
+	if err != nil {
+		return nil, fmt.Errorf("detect framework: %w", err)
+	}
+
+	outputDir := detected.PublicDir(projectRoot)
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return nil, fmt.Errorf("create output dir: %w", err)
+	}
+
+	manifestPath := filepath.Join(outputDir, "manifest.json")
+	manifest := RenderManifest(req.Config)
+	if err := os.WriteFile(manifestPath, manifest, 0o644); err != nil {
+		return nil, fmt.Errorf("write manifest: %w", err)
+	}
+	emit("manifest", 2, "wrote manifest.json", &ArtifactInfo{Path: manifestPath, Type: "manifest"})
+
+	swPath := filepath.Join(outputDir, "sw.js")
+	serviceWorker := RenderServiceWorker(req.Config)
+	if err := os.WriteFile(swPath, serviceWorker, 0o644); err != nil {
+		return nil, fmt.Errorf("write service worker: %w", err)
+	}
+	emit("service_worker", 3, "wrote sw.js", &ArtifactInfo{Path: swPath, Type: "service_worker"})
+
+	files := []string{manifestPath, swPath}
+	var icons []map[string]interface{}
+
+	generateIcons, _ := req.Config["generateIcons"].(bool)
+	if generateIcons {
+		iconSrc, _ := req.Config["iconSource"].(string)
+		if iconSrc != "" {
+			iconPaths, err := GenerateIcons(iconSrc, outputDir, DefaultIconSizes)
+			if err != nil {
+				return nil, fmt.Errorf("generate icons: %w", err)
+			}
+			files = append(files, iconPaths...)
+			for i, path := range iconPaths {
+				icons = append(icons, map[string]interface{}{"path": path, "size": DefaultIconSizes[i]})
+			}
+		}
+	}
+	emit("icons", 4, "generated icons", nil)
+
+	return GenerationResult{
+		"framework": string(detected.Framework),
+		"files":     files,
+		"manifest":  manifestPath,
+		"sw":        swPath,
+		"icons":     icons,
+		"warnings":  []map[string]interface{}{},
+	}, nil
+}
+
+func (b *localBackend) Validate(ctx context.Context, req ValidationRequest) (ValidationResult, error) {
+	detected, err := DetectFramework(req.ProjectRoot)
+	if err != nil {
+		return nil, fmt.Errorf("detect framework: %w", err)
+	}
+
+	outputDir := detected.PublicDir(req.ProjectRoot)
+	checks := []map[string]interface{}{
+		{"name": "manifest.json", "passed": fileExists(filepath.Join(outputDir, "manifest.json"))},
+		{"name": "sw.js", "passed": fileExists(filepath.Join(outputDir, "sw.js"))},
+	}
+
+	passed := true
+	for _, check := range checks {
+		if !check["passed"].(bool) {
+			passed = false
+		}
+	}
+
+	return ValidationResult{
+		"framework": string(detected.Framework),
+		"passed":    passed,
+		"checks":    checks,
+	}, nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -1,24 +1,33 @@
 package universalpwa
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io"
+	"context"
 	"net/http"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 // Config holds the SDK configuration
 type Config struct {
-	ProjectRoot         string
-	AppName             string
-	AppDescription      string
-	Backend             string
-	GenerateIcons       bool
-	APIEndpoint         string
-	Timeout             time.Duration
-	AutoDetectBackend   bool
+	ProjectRoot       string
+	AppName           string
+	AppDescription    string
+	Backend           string
+	GenerateIcons     bool
+	APIEndpoint       string
+	Timeout           time.Duration
+	AutoDetectBackend bool
+	RetryPolicy       RetryPolicy
+	RateLimiter       *rate.Limiter
+	// Mode selects whether Scan/Generate/Validate run against the remote
+	// API, the in-process local backend, or auto-detect between the two.
+	Mode Mode
+	// StrictSchema validates httpBackend responses against the JSON
+	// Schemas under schemas/, returning a *SchemaError on drift instead of
+	// silently decoding a response that no longer matches what the SDK
+	// expects.
+	StrictSchema bool
 }
 
 // NewConfig creates a new configuration with defaults
@@ -29,45 +38,87 @@ func NewConfig(projectRoot string) *Config {
 		APIEndpoint:       "http://localhost:3000",
 		Timeout:           30 * time.Second,
 		AutoDetectBackend: true,
+		RetryPolicy:       DefaultRetryPolicy(),
+		RateLimiter:       rate.NewLimiter(4, 1),
+		Mode:              ModeAuto,
 	}
 }
 
 // Client is the main SDK client
 type Client struct {
-	config *Config
-	client *http.Client
+	config  *Config
+	backend Backend
+	signals *SignalHub
 }
 
-// NewClient creates a new SDK client
-func NewClient(config *Config) *Client {
+// NewClient creates a new SDK client. Options override behavior configured
+// on Config without requiring callers to mutate it directly. Construction
+// never blocks on network I/O: for Config.Mode == ModeAuto, the decision to
+// use the remote API vs. the local backend is made lazily on first call
+// (see autoBackend), not here.
+func NewClient(config *Config, opts ...Option) *Client {
+	o := &clientOptions{
+		httpClient:  &http.Client{Timeout: config.Timeout},
+		limiter:     config.RateLimiter,
+		retryPolicy: config.RetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	return &Client{
-		config: config,
-		client: &http.Client{
-			Timeout: config.Timeout,
-		},
+		config:  config,
+		backend: selectBackend(config, o),
+		signals: newSignalHub(o.retryPolicy, o.logger),
 	}
 }
 
+// Subscribe registers sink to receive generation lifecycle Events matching
+// filter (scan.started, scan.completed, generate.file_written,
+// validate.warning, and similar), returning a SubscriptionID for Unsubscribe.
+func (c *Client) Subscribe(filter EventFilter, sink Sink, opts ...SubscribeOption) (SubscriptionID, error) {
+	return c.signals.Subscribe(filter, sink, opts...)
+}
+
+// Unsubscribe stops delivery to a subscription created by Subscribe.
+func (c *Client) Unsubscribe(id SubscriptionID) {
+	c.signals.Unsubscribe(id)
+}
+
 // ScanResult represents the result of a project scan
 type ScanResult map[string]interface{}
 
 // Scan scans the project for framework and features
-func (c *Client) Scan() (ScanResult, error) {
-	request := map[string]interface{}{
-		"projectRoot":       c.config.ProjectRoot,
-		"autoDetectBackend": c.config.AutoDetectBackend,
-	}
+func (c *Client) Scan() (ScanReport, error) {
+	return c.ScanContext(context.Background())
+}
 
-	var result ScanResult
-	err := c.post("/api/scan", request, &result)
-	return result, err
+// ScanContext is Scan with a caller-supplied context for cancellation.
+func (c *Client) ScanContext(ctx context.Context) (ScanReport, error) {
+	c.publish("scan.started", nil)
+	result, err := c.backend.Scan(ctx, ScanRequest{
+		ProjectRoot:       c.config.ProjectRoot,
+		AutoDetectBackend: c.config.AutoDetectBackend,
+	})
+	if err != nil {
+		c.publish("scan.failed", map[string]interface{}{"error": err.Error()})
+		return ScanReport{}, err
+	}
+	c.publish("scan.completed", map[string]interface{}{"result": result})
+	return parseScanReport(result, c.backendKind()), nil
 }
 
 // GenerationResult represents the result of PWA generation
 type GenerationResult map[string]interface{}
 
 // Generate generates PWA files
-func (c *Client) Generate(overrides map[string]interface{}) (GenerationResult, error) {
+func (c *Client) Generate(overrides map[string]interface{}) (GenerationReport, error) {
+	return c.GenerateContext(context.Background(), overrides)
+}
+
+// GenerateContext is Generate with a caller-supplied context for
+// cancellation.
+func (c *Client) GenerateContext(ctx context.Context, overrides map[string]interface{}) (GenerationReport, error) {
 	config := c.configToMap()
 	if overrides != nil {
 		for k, v := range overrides {
@@ -75,62 +126,62 @@ func (c *Client) Generate(overrides map[string]interface{}) (GenerationResult, e
 		}
 	}
 
-	request := map[string]interface{}{
-		"config": config,
+	c.publish("generate.started", nil)
+	result, err := c.backend.Generate(ctx, GenerationRequest{Config: config})
+	if err != nil {
+		c.publish("generate.failed", map[string]interface{}{"error": err.Error()})
+		return GenerationReport{}, err
 	}
-
-	var result GenerationResult
-	err := c.post("/api/generate", request, &result)
-	return result, err
+	for _, file := range toStringSlice(result["files"]) {
+		c.publish("generate.file_written", map[string]interface{}{"path": file})
+	}
+	c.publish("generate.completed", map[string]interface{}{"result": result})
+	return parseGenerationReport(result), nil
 }
 
 // ValidationResult represents the result of PWA validation
 type ValidationResult map[string]interface{}
 
 // Validate validates the project's PWA readiness
-func (c *Client) Validate() (ValidationResult, error) {
-	request := map[string]interface{}{
-		"projectRoot": c.config.ProjectRoot,
-	}
-
-	var result ValidationResult
-	err := c.post("/api/validate", request, &result)
-	return result, err
+func (c *Client) Validate() (ValidationReport, error) {
+	return c.ValidateContext(context.Background())
 }
 
-// Helper methods
-
-func (c *Client) post(path string, data interface{}, result interface{}) error {
-	url := c.config.APIEndpoint + path
-
-	body, err := json.Marshal(data)
+// ValidateContext is Validate with a caller-supplied context for
+// cancellation.
+func (c *Client) ValidateContext(ctx context.Context) (ValidationReport, error) {
+	result, err := c.backend.Validate(ctx, ValidationRequest{ProjectRoot: c.config.ProjectRoot})
 	if err != nil {
-		return fmt.Errorf("failed to marshal request: %w", err)
+		return ValidationReport{}, err
 	}
-
-	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	if passed, ok := result["passed"].(bool); ok && !passed {
+		c.publish("validate.warning", map[string]interface{}{"result": result})
 	}
+	return parseValidationReport(result), nil
+}
 
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
+// Helper methods
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+// backendKind reports which concrete Backend this Client resolved to, for
+// ScanReport.Backend.
+func (c *Client) backendKind() DetectedBackend {
+	if ab, ok := c.backend.(*autoBackend); ok {
+		return ab.kind()
 	}
-
-	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-		return fmt.Errorf("failed to decode response: %w", err)
+	if _, ok := c.backend.(*localBackend); ok {
+		return DetectedBackendLocal
 	}
+	return DetectedBackendRemote
+}
 
-	return nil
+// publish fans an Event out to SignalHub subscribers.
+func (c *Client) publish(eventType string, data map[string]interface{}) {
+	c.signals.publish(Event{
+		Type:        eventType,
+		Timestamp:   time.Now(),
+		ProjectRoot: c.config.ProjectRoot,
+		Data:        data,
+	})
 }
 
 func (c *Client) configToMap() map[string]interface{} {
@@ -149,3 +200,23 @@ func (c *Client) configToMap() map[string]interface{} {
 	m["autoDetectBackend"] = c.config.AutoDetectBackend
 	return m
 }
+
+// toStringSlice normalizes a GenerationResult field into []string,
+// accepting both the []string a localBackend result carries directly and
+// the []interface{} a JSON-decoded httpBackend result carries.
+func toStringSlice(v interface{}) []string {
+	switch files := v.(type) {
+	case []string:
+		return files
+	case []interface{}:
+		out := make([]string, 0, len(files))
+		for _, f := range files {
+			if s, ok := f.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
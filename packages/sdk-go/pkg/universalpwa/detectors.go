@@ -0,0 +1,120 @@
+package universalpwa
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// Framework identifies the frontend framework a project is built with.
+type Framework string
+
+const (
+	FrameworkNext      Framework = "next"
+	FrameworkNuxt      Framework = "nuxt"
+	FrameworkSvelteKit Framework = "sveltekit"
+	FrameworkVite      Framework = "vite"
+	FrameworkRemix     Framework = "remix"
+	FrameworkUnknown   Framework = "unknown"
+)
+
+// DetectedFramework is the result of inspecting a project directory for a
+// known framework's config files and package.json dependencies.
+type DetectedFramework struct {
+	Framework  Framework
+	Version    string
+	Confidence float64
+	Features   []string
+}
+
+// configMarkers pairs each framework with the config files that identify it,
+// checked in order from most to least specific.
+var configMarkers = []struct {
+	framework Framework
+	files     []string
+	dep       string
+}{
+	{FrameworkNext, []string{"next.config.js", "next.config.mjs", "next.config.ts"}, "next"},
+	{FrameworkNuxt, []string{"nuxt.config.js", "nuxt.config.ts"}, "nuxt"},
+	{FrameworkSvelteKit, []string{"svelte.config.js"}, "@sveltejs/kit"},
+	{FrameworkRemix, []string{"remix.config.js"}, "@remix-run/react"},
+	{FrameworkVite, []string{"vite.config.js", "vite.config.ts"}, "vite"},
+}
+
+// DetectFramework inspects projectRoot for known framework config files and
+// falls back to scanning package.json dependencies when no config file is
+// present.
+func DetectFramework(projectRoot string) (DetectedFramework, error) {
+	for _, marker := range configMarkers {
+		for _, file := range marker.files {
+			if fileExists(filepath.Join(projectRoot, file)) {
+				return DetectedFramework{
+					Framework:  marker.framework,
+					Confidence: 0.95,
+					Features:   []string{file},
+				}, nil
+			}
+		}
+	}
+
+	deps, err := readPackageJSONDeps(projectRoot)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return DetectedFramework{Framework: FrameworkUnknown}, nil
+		}
+		return DetectedFramework{}, err
+	}
+
+	for _, marker := range configMarkers {
+		if version, ok := deps[marker.dep]; ok {
+			return DetectedFramework{
+				Framework:  marker.framework,
+				Version:    version,
+				Confidence: 0.6,
+				Features:   []string{"package.json:" + marker.dep},
+			}, nil
+		}
+	}
+
+	return DetectedFramework{Framework: FrameworkUnknown, Confidence: 0}, nil
+}
+
+func readPackageJSONDeps(projectRoot string) (map[string]string, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, err
+	}
+
+	deps := make(map[string]string, len(pkg.Dependencies)+len(pkg.DevDependencies))
+	for name, version := range pkg.Dependencies {
+		deps[name] = version
+	}
+	for name, version := range pkg.DevDependencies {
+		deps[name] = version
+	}
+	return deps, nil
+}
+
+// PublicDir returns the directory generated PWA assets should be written
+// to for this framework (the directory conventionally served as static
+// assets at the site root).
+func (d DetectedFramework) PublicDir(projectRoot string) string {
+	switch d.Framework {
+	case FrameworkNuxt:
+		return filepath.Join(projectRoot, "public")
+	case FrameworkSvelteKit:
+		return filepath.Join(projectRoot, "static")
+	case FrameworkRemix:
+		return filepath.Join(projectRoot, "public")
+	default: // Next, Vite, and unknown projects conventionally serve from public/
+		return filepath.Join(projectRoot, "public")
+	}
+}
@@ -0,0 +1,58 @@
+package universalpwa
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDetectFrameworkByConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "next.config.js"), "module.exports = {}")
+
+	detected, err := DetectFramework(dir)
+	if err != nil {
+		t.Fatalf("DetectFramework: %v", err)
+	}
+	if detected.Framework != FrameworkNext {
+		t.Fatalf("Framework = %q, want %q", detected.Framework, FrameworkNext)
+	}
+	if detected.Confidence <= 0.5 {
+		t.Fatalf("Confidence = %v, want a high-confidence match", detected.Confidence)
+	}
+}
+
+func TestDetectFrameworkByPackageJSON(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "package.json"), `{"devDependencies": {"vite": "^5.0.0"}}`)
+
+	detected, err := DetectFramework(dir)
+	if err != nil {
+		t.Fatalf("DetectFramework: %v", err)
+	}
+	if detected.Framework != FrameworkVite {
+		t.Fatalf("Framework = %q, want %q", detected.Framework, FrameworkVite)
+	}
+	if detected.Version != "^5.0.0" {
+		t.Fatalf("Version = %q, want %q", detected.Version, "^5.0.0")
+	}
+}
+
+func TestDetectFrameworkUnknown(t *testing.T) {
+	dir := t.TempDir()
+
+	detected, err := DetectFramework(dir)
+	if err != nil {
+		t.Fatalf("DetectFramework: %v", err)
+	}
+	if detected.Framework != FrameworkUnknown {
+		t.Fatalf("Framework = %q, want %q", detected.Framework, FrameworkUnknown)
+	}
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
@@ -0,0 +1,227 @@
+package universalpwa
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Event is a generation lifecycle event fanned out by SignalHub, e.g.
+// "scan.started", "scan.completed", "generate.file_written",
+// "validate.warning".
+type Event struct {
+	Type        string
+	Timestamp   time.Time
+	ProjectRoot string
+	Data        map[string]interface{}
+}
+
+// EventFilter selects which Events a subscriber receives. A zero-value
+// EventFilter matches every event.
+type EventFilter struct {
+	Types []string
+}
+
+func (f EventFilter) matches(e Event) bool {
+	if len(f.Types) == 0 {
+		return true
+	}
+	for _, t := range f.Types {
+		if t == e.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// Sink receives delivered Events. HTTP webhooks, Unix-socket consumers, and
+// plain Go callbacks (via SinkFunc) all implement Sink.
+type Sink interface {
+	Deliver(ctx context.Context, event Event) error
+}
+
+// SinkFunc adapts a plain callback to the Sink interface.
+type SinkFunc func(Event) error
+
+func (f SinkFunc) Deliver(ctx context.Context, event Event) error { return f(event) }
+
+// DropPolicy controls what SignalHub does when a subscriber's buffer is full.
+type DropPolicy int
+
+const (
+	// DropOldest discards the oldest buffered event to make room.
+	DropOldest DropPolicy = iota
+	// DropNewest discards the incoming event, keeping the buffer as-is.
+	DropNewest
+	// Block waits for the subscriber to free up buffer space.
+	Block
+)
+
+// SubscriptionID identifies a SignalHub subscription returned by Subscribe.
+type SubscriptionID string
+
+// SubscribeOption configures a single Subscribe call.
+type SubscribeOption func(*subscription)
+
+// WithBufferSize overrides the default per-sink buffer size (32).
+func WithBufferSize(n int) SubscribeOption {
+	return func(s *subscription) { s.bufferSize = n }
+}
+
+// WithDropPolicy overrides the default DropPolicy (DropOldest).
+func WithDropPolicy(policy DropPolicy) SubscribeOption {
+	return func(s *subscription) { s.dropPolicy = policy }
+}
+
+const defaultSinkBufferSize = 32
+
+type subscription struct {
+	filter      EventFilter
+	sink        Sink
+	bufferSize  int
+	dropPolicy  DropPolicy
+	queue       chan Event
+	cancel      context.CancelFunc
+	retryPolicy RetryPolicy
+	logger      Logger
+}
+
+func (s *subscription) enqueue(event Event) {
+	select {
+	case s.queue <- event:
+		return
+	default:
+	}
+
+	switch s.dropPolicy {
+	case DropNewest:
+		return
+	case Block:
+		s.queue <- event
+	default: // DropOldest
+		select {
+		case <-s.queue:
+		default:
+		}
+		select {
+		case s.queue <- event:
+		default:
+		}
+	}
+}
+
+func (s *subscription) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.queue:
+			s.deliver(ctx, event)
+		}
+	}
+}
+
+func (s *subscription) deliver(ctx context.Context, event Event) {
+	var lastErr error
+	for attempt := 0; attempt <= s.retryPolicy.MaxRetries; attempt++ {
+		if err := s.sink.Deliver(ctx, event); err != nil {
+			lastErr = err
+			if attempt < s.retryPolicy.MaxRetries {
+				if sleepErr := sleepCtx(ctx, s.retryPolicy.backoff(attempt, "")); sleepErr != nil {
+					return
+				}
+				continue
+			}
+			if s.logger != nil {
+				s.logger.Printf("signal hub: giving up delivering %s after %d attempts: %v", event.Type, attempt+1, lastErr)
+			}
+			return
+		}
+		return
+	}
+}
+
+// SignalHub fans out generation lifecycle Events to registered Sinks.
+type SignalHub struct {
+	mu          sync.Mutex
+	subs        map[SubscriptionID]*subscription
+	nextID      uint64
+	retryPolicy RetryPolicy
+	logger      Logger
+}
+
+func newSignalHub(retryPolicy RetryPolicy, logger Logger) *SignalHub {
+	return &SignalHub{
+		subs:        make(map[SubscriptionID]*subscription),
+		retryPolicy: retryPolicy,
+		logger:      logger,
+	}
+}
+
+// Subscribe registers sink to receive Events matching filter, returning a
+// SubscriptionID for later Unsubscribe.
+func (h *SignalHub) Subscribe(filter EventFilter, sink Sink, opts ...SubscribeOption) (SubscriptionID, error) {
+	sub := &subscription{
+		filter:      filter,
+		sink:        sink,
+		bufferSize:  defaultSinkBufferSize,
+		dropPolicy:  DropOldest,
+		retryPolicy: h.retryPolicy,
+		logger:      h.logger,
+	}
+	for _, opt := range opts {
+		opt(sub)
+	}
+	sub.queue = make(chan Event, sub.bufferSize)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sub.cancel = cancel
+
+	h.mu.Lock()
+	h.nextID++
+	id := SubscriptionID("sub-" + strconv.FormatUint(h.nextID, 10))
+	h.subs[id] = sub
+	h.mu.Unlock()
+
+	go sub.run(ctx)
+
+	return id, nil
+}
+
+// Unsubscribe stops delivery to the given subscription and releases its
+// resources.
+func (h *SignalHub) Unsubscribe(id SubscriptionID) {
+	h.mu.Lock()
+	sub, ok := h.subs[id]
+	if ok {
+		delete(h.subs, id)
+	}
+	h.mu.Unlock()
+
+	if ok {
+		sub.cancel()
+	}
+}
+
+// publish fans event out to every subscription whose filter matches.
+// Matching subscriptions are snapshotted under h.mu, then each enqueued in
+// its own goroutine after releasing it: subscription.enqueue can block
+// (DropPolicy Block) on a stalled consumer, and doing that while holding
+// h.mu -- or even just serially after releasing it -- would stall delivery
+// to every other subscriber and deadlock a concurrent Unsubscribe, which
+// also needs h.mu to remove the stalled subscription.
+func (h *SignalHub) publish(event Event) {
+	h.mu.Lock()
+	matching := make([]*subscription, 0, len(h.subs))
+	for _, sub := range h.subs {
+		if sub.filter.matches(event) {
+			matching = append(matching, sub)
+		}
+	}
+	h.mu.Unlock()
+
+	for _, sub := range matching {
+		go sub.enqueue(event)
+	}
+}
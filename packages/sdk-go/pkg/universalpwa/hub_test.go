@@ -0,0 +1,219 @@
+package universalpwa
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestSignalHubDeliverDoesNotPanicOnZeroMaxRetryDelay guards against
+// subscription.deliver reusing RetryPolicy.backoff unsafely: a RetryPolicy
+// built with only MinRetryDelay set (no MaxRetryDelay) previously panicked
+// on its first retry.
+func TestSignalHubDeliverDoesNotPanicOnZeroMaxRetryDelay(t *testing.T) {
+	hub := newSignalHub(RetryPolicy{MaxRetries: 2, MinRetryDelay: time.Millisecond}, nil)
+
+	delivered := make(chan struct{}, 1)
+	attempts := 0
+	sink := SinkFunc(func(Event) error {
+		attempts++
+		if attempts < 2 {
+			return errTransient
+		}
+		delivered <- struct{}{}
+		return nil
+	})
+
+	if _, err := hub.Subscribe(EventFilter{}, sink); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	hub.publish(Event{Type: "scan.started", Timestamp: time.Now()})
+
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		t.Fatal("event was never delivered (deliver likely panicked before finishing retries)")
+	}
+}
+
+// TestSubscriptionDeliverStopsOnContextCancel guards against deliver's retry
+// backoff ignoring ctx: before this fix it slept out the full backoff on a
+// cancelled ctx, delaying Unsubscribe's shutdown by up to the whole retry
+// budget instead of returning as soon as ctx is done.
+func TestSubscriptionDeliverStopsOnContextCancel(t *testing.T) {
+	sub := &subscription{
+		sink:        SinkFunc(func(Event) error { return errTransient }),
+		retryPolicy: RetryPolicy{MaxRetries: 5, MinRetryDelay: time.Minute},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		sub.deliver(ctx, Event{Type: "scan.started"})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("deliver did not return promptly after ctx was cancelled")
+	}
+}
+
+// TestSignalHubPublishDoesNotBlockOnStalledSubscriber guards against publish
+// holding h.mu while calling enqueue: a Block-policy subscriber whose
+// consumer has stalled (a dead webhook, say) previously wedged publish for
+// every other subscriber and deadlocked a concurrent Unsubscribe, since both
+// needed the same lock.
+func TestSignalHubPublishDoesNotBlockOnStalledSubscriber(t *testing.T) {
+	hub := newSignalHub(RetryPolicy{MaxRetries: 0}, nil)
+
+	entered := make(chan struct{}, 1)
+	neverReturns := make(chan struct{})
+	stalledID, err := hub.Subscribe(EventFilter{}, SinkFunc(func(Event) error {
+		select {
+		case entered <- struct{}{}:
+		default:
+		}
+		<-neverReturns
+		return nil
+	}), WithBufferSize(1), WithDropPolicy(Block))
+	if err != nil {
+		t.Fatalf("Subscribe (stalled): %v", err)
+	}
+
+	otherDelivered := make(chan struct{}, 1)
+	if _, err := hub.Subscribe(EventFilter{}, SinkFunc(func(Event) error {
+		select {
+		case otherDelivered <- struct{}{}:
+		default:
+		}
+		return nil
+	})); err != nil {
+		t.Fatalf("Subscribe (other): %v", err)
+	}
+
+	// First event is picked up by the stalled subscription's run loop and
+	// wedges its sink forever, leaving the queue empty but never drained
+	// again.
+	hub.publish(Event{Type: "first"})
+	select {
+	case <-entered:
+	case <-time.After(time.Second):
+		t.Fatal("stalled subscriber's sink was never invoked")
+	}
+	// Drain the other subscriber's delivery of the first event, so the check
+	// below only passes once the second publish reaches it.
+	select {
+	case <-otherDelivered:
+	case <-time.After(time.Second):
+		t.Fatal("first event was never delivered to the non-stalled subscriber")
+	}
+
+	// Fill the now-undrained queue so the next Block-policy enqueue has no
+	// room and must block.
+	hub.mu.Lock()
+	hub.subs[stalledID].queue <- Event{Type: "filler"}
+	hub.mu.Unlock()
+
+	publishDone := make(chan struct{})
+	go func() {
+		hub.publish(Event{Type: "scan.started", Timestamp: time.Now()})
+		close(publishDone)
+	}()
+
+	select {
+	case <-otherDelivered:
+	case <-time.After(time.Second):
+		t.Fatal("publish blocked delivery to a non-stalled subscriber")
+	}
+
+	unsubscribeDone := make(chan struct{})
+	go func() {
+		hub.Unsubscribe(stalledID)
+		close(unsubscribeDone)
+	}()
+
+	select {
+	case <-unsubscribeDone:
+	case <-time.After(time.Second):
+		t.Fatal("Unsubscribe deadlocked behind publish's lock")
+	}
+
+	select {
+	case <-publishDone:
+	case <-time.After(time.Second):
+		t.Fatal("publish never returned")
+	}
+}
+
+type sentinelError string
+
+func (e sentinelError) Error() string { return string(e) }
+
+const errTransient = sentinelError("transient delivery failure")
+
+func TestSubscriptionEnqueueDropOldest(t *testing.T) {
+	sub := &subscription{dropPolicy: DropOldest, queue: make(chan Event, 2)}
+
+	sub.enqueue(Event{Type: "a"})
+	sub.enqueue(Event{Type: "b"})
+	sub.enqueue(Event{Type: "c"})
+
+	if got := (<-sub.queue).Type; got != "b" {
+		t.Fatalf("first queued event = %q, want %q (oldest should have been dropped)", got, "b")
+	}
+	if got := (<-sub.queue).Type; got != "c" {
+		t.Fatalf("second queued event = %q, want %q", got, "c")
+	}
+}
+
+func TestSubscriptionEnqueueDropNewest(t *testing.T) {
+	sub := &subscription{dropPolicy: DropNewest, queue: make(chan Event, 2)}
+
+	sub.enqueue(Event{Type: "a"})
+	sub.enqueue(Event{Type: "b"})
+	sub.enqueue(Event{Type: "c"})
+
+	if got := (<-sub.queue).Type; got != "a" {
+		t.Fatalf("first queued event = %q, want %q", got, "a")
+	}
+	if got := (<-sub.queue).Type; got != "b" {
+		t.Fatalf("second queued event = %q, want %q (incoming event should have been dropped)", got, "b")
+	}
+}
+
+func TestSubscriptionEnqueueBlockWaitsForSpace(t *testing.T) {
+	sub := &subscription{dropPolicy: Block, queue: make(chan Event, 1)}
+
+	sub.enqueue(Event{Type: "a"})
+
+	done := make(chan struct{})
+	go func() {
+		sub.enqueue(Event{Type: "b"})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("enqueue returned before the queue had space")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if got := (<-sub.queue).Type; got != "a" {
+		t.Fatalf("first queued event = %q, want %q", got, "a")
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("blocked enqueue never completed after space freed up")
+	}
+
+	if got := (<-sub.queue).Type; got != "b" {
+		t.Fatalf("second queued event = %q, want %q", got, "b")
+	}
+}
@@ -0,0 +1,71 @@
+package universalpwa
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+)
+
+// DefaultIconSizes are the PNG icon sizes UniversalPWA generates by default,
+// covering the sizes referenced by RenderManifest plus common favicon sizes.
+var DefaultIconSizes = []int{192, 512, 32, 16}
+
+// GenerateIcons reads the PNG at srcPath and writes a resized copy for each
+// requested size to outDir, named icon-<size>.png. It returns the written
+// file paths.
+func GenerateIcons(srcPath, outDir string, sizes []int) ([]string, error) {
+	f, err := os.Open(srcPath)
+	if err != nil {
+		return nil, fmt.Errorf("open icon source: %w", err)
+	}
+	defer f.Close()
+
+	src, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("decode icon source: %w", err)
+	}
+
+	paths := make([]string, 0, len(sizes))
+	for _, size := range sizes {
+		resized := resizeNearestNeighbor(src, size, size)
+
+		outPath := filepath.Join(outDir, fmt.Sprintf("icon-%d.png", size))
+		out, err := os.Create(outPath)
+		if err != nil {
+			return nil, fmt.Errorf("create icon file: %w", err)
+		}
+		if err := png.Encode(out, resized); err != nil {
+			out.Close()
+			return nil, fmt.Errorf("encode icon: %w", err)
+		}
+		if err := out.Close(); err != nil {
+			return nil, fmt.Errorf("close icon file: %w", err)
+		}
+
+		paths = append(paths, outPath)
+	}
+
+	return paths, nil
+}
+
+// resizeNearestNeighbor scales src to width x height using nearest-neighbor
+// sampling. It's a deliberately simple algorithm: icon sources are small and
+// generation runs offline, so quality-per-cycle tradeoffs don't matter here.
+func resizeNearestNeighbor(src image.Image, width, height int) image.Image {
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	srcBounds := src.Bounds()
+	srcW, srcH := srcBounds.Dx(), srcBounds.Dy()
+
+	for y := 0; y < height; y++ {
+		srcY := srcBounds.Min.Y + y*srcH/height
+		for x := 0; x < width; x++ {
+			srcX := srcBounds.Min.X + x*srcW/width
+			draw.Draw(dst, image.Rect(x, y, x+1, y+1), src, image.Point{srcX, srcY}, draw.Src)
+		}
+	}
+
+	return dst
+}
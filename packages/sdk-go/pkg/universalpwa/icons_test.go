@@ -0,0 +1,73 @@
+package universalpwa
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateIconsResizesToRequestedSizes(t *testing.T) {
+	dir := t.TempDir()
+	srcPath := filepath.Join(dir, "source.png")
+	writeSolidPNG(t, srcPath, 1024, color.RGBA{R: 10, G: 20, B: 30, A: 255})
+
+	sizes := []int{192, 32}
+	paths, err := GenerateIcons(srcPath, dir, sizes)
+	if err != nil {
+		t.Fatalf("GenerateIcons: %v", err)
+	}
+	if len(paths) != len(sizes) {
+		t.Fatalf("got %d paths, want %d", len(paths), len(sizes))
+	}
+
+	for i, path := range paths {
+		img := readPNG(t, path)
+		bounds := img.Bounds()
+		if bounds.Dx() != sizes[i] || bounds.Dy() != sizes[i] {
+			t.Fatalf("%s: got %dx%d, want %dx%d", path, bounds.Dx(), bounds.Dy(), sizes[i], sizes[i])
+		}
+
+		r, g, b, _ := img.At(bounds.Min.X, bounds.Min.Y).RGBA()
+		if r>>8 != 10 || g>>8 != 20 || b>>8 != 30 {
+			t.Fatalf("%s: pixel color changed during resize: got (%d,%d,%d)", path, r>>8, g>>8, b>>8)
+		}
+	}
+}
+
+func writeSolidPNG(t *testing.T, path string, size int, c color.RGBA) {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			img.Set(x, y, c)
+		}
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create %s: %v", path, err)
+	}
+	defer f.Close()
+
+	if err := png.Encode(f, img); err != nil {
+		t.Fatalf("encode %s: %v", path, err)
+	}
+}
+
+func readPNG(t *testing.T, path string) image.Image {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open %s: %v", path, err)
+	}
+	defer f.Close()
+
+	img, err := png.Decode(f)
+	if err != nil {
+		t.Fatalf("decode %s: %v", path, err)
+	}
+	return img
+}
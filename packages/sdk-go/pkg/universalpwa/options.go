@@ -0,0 +1,52 @@
+package universalpwa
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// Logger is the minimal logging interface Client writes diagnostics to.
+// *log.Logger satisfies this interface.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// clientOptions accumulates Option values before the Backend is constructed.
+type clientOptions struct {
+	httpClient  *http.Client
+	limiter     *rate.Limiter
+	retryPolicy RetryPolicy
+	logger      Logger
+}
+
+// Option configures a Client at construction time.
+type Option func(*clientOptions)
+
+// WithRateLimit overrides the default outgoing request rate limit.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(o *clientOptions) {
+		o.limiter = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+}
+
+// WithRetryPolicy overrides the default RetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *clientOptions) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithLogger attaches a Logger used for retry and request diagnostics.
+func WithLogger(logger Logger) Option {
+	return func(o *clientOptions) {
+		o.logger = logger
+	}
+}
+
+// WithHTTPClient overrides the *http.Client used for outgoing requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = httpClient
+	}
+}
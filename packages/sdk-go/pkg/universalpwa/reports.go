@@ -0,0 +1,197 @@
+package universalpwa
+
+// Feature is a PWA capability detected (or not) in the scanned project.
+type Feature struct {
+	Name      string
+	Supported bool
+}
+
+// DetectedBackend records which Backend produced a report.
+type DetectedBackend string
+
+const (
+	DetectedBackendRemote DetectedBackend = "remote"
+	DetectedBackendLocal  DetectedBackend = "local"
+)
+
+// ScanReport is the typed form of ScanResult. Raw holds the untyped
+// response so callers relying on specific map keys keep working across SDK
+// versions.
+type ScanReport struct {
+	Framework  string
+	Version    string
+	Features   []Feature
+	Backend    DetectedBackend
+	Confidence float64
+	Raw        ScanResult
+}
+
+func parseScanReport(result ScanResult, backend DetectedBackend) ScanReport {
+	report := ScanReport{Backend: backend, Raw: result}
+	report.Framework, _ = result["framework"].(string)
+	report.Version, _ = result["version"].(string)
+	report.Confidence, _ = result["confidence"].(float64)
+
+	for _, raw := range toInterfaceSlice(result["features"]) {
+		if name, ok := raw.(string); ok {
+			report.Features = append(report.Features, Feature{Name: name, Supported: true})
+			continue
+		}
+		if m, ok := raw.(map[string]interface{}); ok {
+			name, _ := m["name"].(string)
+			supported, _ := m["supported"].(bool)
+			report.Features = append(report.Features, Feature{Name: name, Supported: supported})
+		}
+	}
+
+	return report
+}
+
+// GeneratedFile is a single file written during generation.
+type GeneratedFile struct {
+	Path string
+	Type string
+}
+
+// IconArtifact is a generated PWA icon.
+type IconArtifact struct {
+	Path string
+	Size int
+}
+
+// Diagnostic is a non-fatal issue surfaced during generation or validation.
+type Diagnostic struct {
+	Severity string
+	Message  string
+}
+
+// GenerationReport is the typed form of GenerationResult.
+type GenerationReport struct {
+	Files             []GeneratedFile
+	ManifestPath      string
+	ServiceWorkerPath string
+	Icons             []IconArtifact
+	Warnings          []Diagnostic
+	Raw               GenerationResult
+}
+
+func parseGenerationReport(result GenerationResult) GenerationReport {
+	report := GenerationReport{Raw: result}
+	report.ManifestPath, _ = result["manifest"].(string)
+	report.ServiceWorkerPath, _ = result["sw"].(string)
+
+	for _, path := range toStringSlice(result["files"]) {
+		report.Files = append(report.Files, GeneratedFile{Path: path})
+	}
+
+	for _, raw := range toInterfaceSlice(result["icons"]) {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		path, _ := m["path"].(string)
+		report.Icons = append(report.Icons, IconArtifact{Path: path, Size: toInt(m["size"])})
+	}
+
+	for _, raw := range toInterfaceSlice(result["warnings"]) {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		severity, _ := m["severity"].(string)
+		message, _ := m["message"].(string)
+		report.Warnings = append(report.Warnings, Diagnostic{Severity: severity, Message: message})
+	}
+
+	return report
+}
+
+// CheckResult is the outcome of a single validation check.
+type CheckResult struct {
+	Name    string
+	Passed  bool
+	Message string
+}
+
+// LHScore mirrors the category scores Lighthouse reports, on a 0-100 scale.
+type LHScore struct {
+	Performance   float64
+	Accessibility float64
+	BestPractices float64
+	SEO           float64
+	PWA           float64
+}
+
+// ValidationReport is the typed form of ValidationResult.
+type ValidationReport struct {
+	Passed          bool
+	Checks          []CheckResult
+	LighthouseScore *LHScore
+	Raw             ValidationResult
+}
+
+func parseValidationReport(result ValidationResult) ValidationReport {
+	report := ValidationReport{Raw: result}
+	report.Passed, _ = result["passed"].(bool)
+
+	for _, raw := range toInterfaceSlice(result["checks"]) {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := m["name"].(string)
+		passed, _ := m["passed"].(bool)
+		message, _ := m["message"].(string)
+		report.Checks = append(report.Checks, CheckResult{Name: name, Passed: passed, Message: message})
+	}
+
+	if lh, ok := result["lighthouse"].(map[string]interface{}); ok {
+		score := &LHScore{}
+		score.Performance, _ = lh["performance"].(float64)
+		score.Accessibility, _ = lh["accessibility"].(float64)
+		score.BestPractices, _ = lh["bestPractices"].(float64)
+		score.SEO, _ = lh["seo"].(float64)
+		score.PWA, _ = lh["pwa"].(float64)
+		report.LighthouseScore = score
+	}
+
+	return report
+}
+
+// toInt normalizes a map value that should be an integer, accepting both the
+// int a localBackend result carries directly and the float64 a JSON-decoded
+// httpBackend result carries.
+func toInt(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case float64:
+		return int(n)
+	default:
+		return 0
+	}
+}
+
+// toInterfaceSlice normalizes a map value that should be a JSON array into
+// []interface{}, regardless of whether it arrived as a Go-native slice
+// (localBackend) or was JSON-decoded (httpBackend).
+func toInterfaceSlice(v interface{}) []interface{} {
+	switch s := v.(type) {
+	case []interface{}:
+		return s
+	case []string:
+		out := make([]interface{}, len(s))
+		for i, item := range s {
+			out[i] = item
+		}
+		return out
+	case []map[string]interface{}:
+		out := make([]interface{}, len(s))
+		for i, item := range s {
+			out[i] = item
+		}
+		return out
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,196 @@
+package universalpwa
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// jsonRoundTrip decodes v into a map[string]interface{}, matching the shape
+// httpBackend hands to parse*Report (JSON numbers as float64, arrays as
+// []interface{}), as opposed to the Go-native types localBackend constructs
+// directly.
+func jsonRoundTrip(t *testing.T, v interface{}) map[string]interface{} {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	var out map[string]interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	return out
+}
+
+func TestParseScanReportLocalShape(t *testing.T) {
+	result := ScanResult{
+		"framework":  "next",
+		"version":    "14.0.0",
+		"confidence": 0.92,
+		"features":   []string{"offline-cache", "push-notifications"},
+	}
+
+	report := parseScanReport(result, DetectedBackendLocal)
+
+	if report.Framework != "next" || report.Version != "14.0.0" {
+		t.Fatalf("Framework/Version = %q/%q, want next/14.0.0", report.Framework, report.Version)
+	}
+	if report.Confidence != 0.92 {
+		t.Fatalf("Confidence = %v, want 0.92", report.Confidence)
+	}
+	if report.Backend != DetectedBackendLocal {
+		t.Fatalf("Backend = %q, want %q", report.Backend, DetectedBackendLocal)
+	}
+	if len(report.Features) != 2 || report.Features[0].Name != "offline-cache" || !report.Features[0].Supported {
+		t.Fatalf("Features = %+v, want 2 supported features starting with offline-cache", report.Features)
+	}
+}
+
+func TestParseScanReportHTTPShape(t *testing.T) {
+	result := ScanResult(jsonRoundTrip(t, map[string]interface{}{
+		"framework":  "sveltekit",
+		"version":    "2.0.0",
+		"confidence": 0.75,
+		"features": []map[string]interface{}{
+			{"name": "manifest", "supported": true},
+			{"name": "background-sync", "supported": false},
+		},
+	}))
+
+	report := parseScanReport(result, DetectedBackendRemote)
+
+	if report.Framework != "sveltekit" {
+		t.Fatalf("Framework = %q, want sveltekit", report.Framework)
+	}
+	if len(report.Features) != 2 {
+		t.Fatalf("len(Features) = %d, want 2", len(report.Features))
+	}
+	if report.Features[0].Name != "manifest" || !report.Features[0].Supported {
+		t.Fatalf("Features[0] = %+v, want supported manifest", report.Features[0])
+	}
+	if report.Features[1].Name != "background-sync" || report.Features[1].Supported {
+		t.Fatalf("Features[1] = %+v, want unsupported background-sync", report.Features[1])
+	}
+}
+
+func TestParseGenerationReportLocalShape(t *testing.T) {
+	result := GenerationResult{
+		"manifest": "public/manifest.json",
+		"sw":       "public/sw.js",
+		"files":    []string{"public/manifest.json", "public/sw.js"},
+		"icons": []map[string]interface{}{
+			{"path": "public/icon-192.png", "size": 192},
+		},
+		"warnings": []map[string]interface{}{
+			{"severity": "warn", "message": "no maskable icon provided"},
+		},
+	}
+
+	report := parseGenerationReport(result)
+
+	if report.ManifestPath != "public/manifest.json" || report.ServiceWorkerPath != "public/sw.js" {
+		t.Fatalf("ManifestPath/ServiceWorkerPath = %q/%q", report.ManifestPath, report.ServiceWorkerPath)
+	}
+	if len(report.Files) != 2 {
+		t.Fatalf("len(Files) = %d, want 2", len(report.Files))
+	}
+	if len(report.Icons) != 1 || report.Icons[0].Size != 192 {
+		t.Fatalf("Icons = %+v, want one icon of size 192", report.Icons)
+	}
+	if len(report.Warnings) != 1 || report.Warnings[0].Severity != "warn" {
+		t.Fatalf("Warnings = %+v, want one warn-severity diagnostic", report.Warnings)
+	}
+}
+
+func TestParseGenerationReportHTTPShape(t *testing.T) {
+	result := GenerationResult(jsonRoundTrip(t, map[string]interface{}{
+		"manifest": "public/manifest.json",
+		"sw":       "public/sw.js",
+		"files":    []string{"public/manifest.json", "public/sw.js", "public/icon-512.png"},
+		"icons": []map[string]interface{}{
+			{"path": "public/icon-512.png", "size": 512},
+		},
+	}))
+
+	report := parseGenerationReport(result)
+
+	if len(report.Files) != 3 {
+		t.Fatalf("len(Files) = %d, want 3", len(report.Files))
+	}
+	if len(report.Icons) != 1 || report.Icons[0].Path != "public/icon-512.png" || report.Icons[0].Size != 512 {
+		t.Fatalf("Icons = %+v, want one 512px icon", report.Icons)
+	}
+	if len(report.Warnings) != 0 {
+		t.Fatalf("Warnings = %+v, want none", report.Warnings)
+	}
+}
+
+func TestParseValidationReportLocalShape(t *testing.T) {
+	result := ValidationResult{
+		"passed": true,
+		"checks": []map[string]interface{}{
+			{"name": "manifest-present", "passed": true, "message": "manifest.json found"},
+		},
+	}
+
+	report := parseValidationReport(result)
+
+	if !report.Passed {
+		t.Fatal("Passed = false, want true")
+	}
+	if len(report.Checks) != 1 || report.Checks[0].Name != "manifest-present" {
+		t.Fatalf("Checks = %+v, want one manifest-present check", report.Checks)
+	}
+	if report.LighthouseScore != nil {
+		t.Fatalf("LighthouseScore = %+v, want nil when absent from the response", report.LighthouseScore)
+	}
+}
+
+func TestParseValidationReportHTTPShapeWithLighthouse(t *testing.T) {
+	result := ValidationResult(jsonRoundTrip(t, map[string]interface{}{
+		"passed": false,
+		"checks": []map[string]interface{}{
+			{"name": "icons-present", "passed": false, "message": "missing 512x512 icon"},
+		},
+		"lighthouse": map[string]interface{}{
+			"performance":   91.0,
+			"accessibility": 100.0,
+			"bestPractices": 93.0,
+			"seo":           100.0,
+			"pwa":           80.0,
+		},
+	}))
+
+	report := parseValidationReport(result)
+
+	if report.Passed {
+		t.Fatal("Passed = true, want false")
+	}
+	if len(report.Checks) != 1 || report.Checks[0].Passed {
+		t.Fatalf("Checks = %+v, want one failing icons-present check", report.Checks)
+	}
+	if report.LighthouseScore == nil {
+		t.Fatal("LighthouseScore = nil, want a populated score")
+	}
+	if report.LighthouseScore.PWA != 80.0 || report.LighthouseScore.Performance != 91.0 {
+		t.Fatalf("LighthouseScore = %+v, want Performance=91 PWA=80", report.LighthouseScore)
+	}
+}
+
+func TestToInterfaceSlice(t *testing.T) {
+	if got := toInterfaceSlice(nil); got != nil {
+		t.Fatalf("toInterfaceSlice(nil) = %v, want nil", got)
+	}
+
+	if got := toInterfaceSlice([]string{"a", "b"}); len(got) != 2 || got[0] != "a" {
+		t.Fatalf("toInterfaceSlice([]string) = %v", got)
+	}
+
+	if got := toInterfaceSlice([]map[string]interface{}{{"name": "a"}}); len(got) != 1 {
+		t.Fatalf("toInterfaceSlice([]map[string]interface{}) = %v, want 1 element", got)
+	}
+
+	if got := toInterfaceSlice([]interface{}{"a", 1}); len(got) != 2 {
+		t.Fatalf("toInterfaceSlice([]interface{}) = %v, want passthrough", got)
+	}
+}
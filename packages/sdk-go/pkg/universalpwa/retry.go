@@ -0,0 +1,104 @@
+package universalpwa
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how Client retries failed requests against the
+// UniversalPWA API.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of attempts after the initial request.
+	MaxRetries int
+	// MinRetryDelay is the base delay used for the first retry.
+	MinRetryDelay time.Duration
+	// MaxRetryDelay caps the computed backoff delay.
+	MaxRetryDelay time.Duration
+	// RetryableStatusCodes lists HTTP status codes that should trigger a retry.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy returns the retry policy used by NewConfig.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:           3,
+		MinRetryDelay:        200 * time.Millisecond,
+		MaxRetryDelay:        5 * time.Second,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+	}
+}
+
+func (p RetryPolicy) isRetryable(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff computes the delay before the given attempt (0-indexed), honoring
+// a Retry-After header when the server provided one.
+func (p RetryPolicy) backoff(attempt int, retryAfter string) time.Duration {
+	if d, ok := parseRetryAfter(retryAfter); ok {
+		return d
+	}
+
+	delay := p.MinRetryDelay << uint(attempt)
+	if delay <= 0 {
+		delay = p.MinRetryDelay
+	}
+	if p.MaxRetryDelay > 0 && delay > p.MaxRetryDelay {
+		delay = p.MaxRetryDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
+
+// sleepCtx blocks for d, or until ctx is done, whichever comes first. It
+// returns ctx.Err() if ctx was done before (or instead of) the sleep
+// completing, so callers retrying in a loop can bail out immediately
+// instead of sleeping through a cancellation.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// parseRetryAfter supports both the delay-seconds and HTTP-date forms of
+// the Retry-After header.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(value); err == nil {
+		if d := time.Until(when); d > 0 {
+			return d, true
+		}
+	}
+
+	return 0, false
+}
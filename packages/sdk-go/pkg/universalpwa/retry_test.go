@@ -0,0 +1,77 @@
+package universalpwa
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoffNoPanic(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy RetryPolicy
+	}{
+		{
+			name:   "zero value policy",
+			policy: RetryPolicy{},
+		},
+		{
+			name:   "partial override without MaxRetryDelay",
+			policy: RetryPolicy{MaxRetries: 3, MinRetryDelay: 200 * time.Millisecond},
+		},
+		{
+			name:   "default policy",
+			policy: DefaultRetryPolicy(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for attempt := 0; attempt < tc.policy.MaxRetries+2; attempt++ {
+				if d := tc.policy.backoff(attempt, ""); d < 0 {
+					t.Fatalf("backoff(%d) returned negative delay %v", attempt, d)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoffRespectsMax(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, MinRetryDelay: 200 * time.Millisecond, MaxRetryDelay: time.Second}
+
+	for attempt := 0; attempt < policy.MaxRetries; attempt++ {
+		if d := policy.backoff(attempt, ""); d > policy.MaxRetryDelay {
+			t.Fatalf("backoff(%d) = %v, want <= %v", attempt, d, policy.MaxRetryDelay)
+		}
+	}
+}
+
+func TestRetryPolicyBackoffHonorsRetryAfter(t *testing.T) {
+	policy := DefaultRetryPolicy()
+
+	if d := policy.backoff(0, "2"); d != 2*time.Second {
+		t.Fatalf("backoff with Retry-After=2 = %v, want 2s", d)
+	}
+}
+
+func TestSleepCtxHonorsCancellation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := sleepCtx(ctx, time.Minute)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected sleepCtx to return ctx.Err() once ctx was done")
+	}
+	if elapsed > time.Second {
+		t.Fatalf("sleepCtx blocked for %v instead of returning when ctx was done", elapsed)
+	}
+}
+
+func TestSleepCtxCompletesNormally(t *testing.T) {
+	if err := sleepCtx(context.Background(), time.Millisecond); err != nil {
+		t.Fatalf("sleepCtx returned an error for an undone context: %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package universalpwa
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+)
+
+//go:embed schemas/*.json
+var schemaFS embed.FS
+
+// jsonSchema is the minimal subset of JSON Schema this SDK checks server
+// responses against: required top-level properties and their basic JSON
+// types. It exists to catch API response drift early, not to be a
+// general-purpose validator.
+type jsonSchema struct {
+	Required   []string                  `json:"required"`
+	Properties map[string]schemaProperty `json:"properties"`
+}
+
+type schemaProperty struct {
+	Type string `json:"type"`
+}
+
+func loadSchema(name string) (*jsonSchema, error) {
+	data, err := schemaFS.ReadFile("schemas/" + name)
+	if err != nil {
+		return nil, fmt.Errorf("load schema %s: %w", name, err)
+	}
+
+	var schema jsonSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("parse schema %s: %w", name, err)
+	}
+	return &schema, nil
+}
+
+// SchemaError reports a response that failed Config.StrictSchema validation,
+// pointing at the offending field.
+type SchemaError struct {
+	Schema  string
+	Pointer string
+	Message string
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("schema %s: %s: %s", e.Schema, e.Pointer, e.Message)
+}
+
+// validateSchema checks that data satisfies the named schema's required
+// properties and basic JSON types, returning a *SchemaError for the first
+// violation found.
+func validateSchema(schemaName string, data map[string]interface{}) error {
+	schema, err := loadSchema(schemaName)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range schema.Required {
+		if _, ok := data[field]; !ok {
+			return &SchemaError{Schema: schemaName, Pointer: "/" + field, Message: "required property missing"}
+		}
+	}
+
+	for field, prop := range schema.Properties {
+		value, present := data[field]
+		if !present || prop.Type == "" {
+			continue
+		}
+		if !jsonTypeMatches(prop.Type, value) {
+			return &SchemaError{Schema: schemaName, Pointer: "/" + field, Message: fmt.Sprintf("expected type %s", prop.Type)}
+		}
+	}
+
+	return nil
+}
+
+func jsonTypeMatches(want string, value interface{}) bool {
+	switch want {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	default:
+		return true
+	}
+}
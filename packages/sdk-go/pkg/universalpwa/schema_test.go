@@ -0,0 +1,46 @@
+package universalpwa
+
+import "testing"
+
+func TestValidateSchemaRequiredField(t *testing.T) {
+	err := validateSchema(scanResponseSchema, map[string]interface{}{
+		"version": "14.0.0",
+	})
+	if err == nil {
+		t.Fatal("expected an error for a response missing the required \"framework\" field")
+	}
+
+	schemaErr, ok := err.(*SchemaError)
+	if !ok {
+		t.Fatalf("got error of type %T, want *SchemaError", err)
+	}
+	if schemaErr.Pointer != "/framework" {
+		t.Fatalf("Pointer = %q, want %q", schemaErr.Pointer, "/framework")
+	}
+}
+
+func TestValidateSchemaTypeMismatch(t *testing.T) {
+	err := validateSchema(validateResponseSchema, map[string]interface{}{
+		"passed": "yes",
+	})
+	if err == nil {
+		t.Fatal("expected an error for \"passed\" being a string instead of a boolean")
+	}
+
+	schemaErr, ok := err.(*SchemaError)
+	if !ok {
+		t.Fatalf("got error of type %T, want *SchemaError", err)
+	}
+	if schemaErr.Pointer != "/passed" {
+		t.Fatalf("Pointer = %q, want %q", schemaErr.Pointer, "/passed")
+	}
+}
+
+func TestValidateSchemaAcceptsValidResponse(t *testing.T) {
+	err := validateSchema(generateResponseSchema, map[string]interface{}{
+		"files": []interface{}{"manifest.json", "sw.js"},
+	})
+	if err != nil {
+		t.Fatalf("validateSchema returned an error for a valid response: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package universalpwa
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// WebhookSink delivers Events as signed HTTP POST requests.
+type WebhookSink struct {
+	url        string
+	secret     []byte
+	httpClient *http.Client
+}
+
+// NewWebhookSink returns a Sink that POSTs each Event as JSON to url,
+// signing the body with HMAC-SHA256 over secret and sending the signature
+// in the X-UniversalPWA-Signature header.
+func NewWebhookSink(url string, secret []byte) *WebhookSink {
+	return &WebhookSink{
+		url:        url,
+		secret:     secret,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (w *WebhookSink) Deliver(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-UniversalPWA-Signature", w.sign(body))
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (w *WebhookSink) sign(body []byte) string {
+	mac := hmac.New(sha256.New, w.secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SocketSink delivers Events as newline-delimited JSON to a Unix domain
+// socket or named pipe. Each delivery dials a fresh connection so a slow or
+// absent consumer can't hold the hub's delivery goroutine hostage.
+type SocketSink struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewSocketSink returns a Sink that writes to the Unix socket or named pipe
+// at address. network is typically "unix".
+func NewSocketSink(network, address string) *SocketSink {
+	return &SocketSink{network: network, address: address, timeout: 5 * time.Second}
+}
+
+func (s *SocketSink) Deliver(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return fmt.Errorf("dial %s %s: %w", s.network, s.address, err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetWriteDeadline(deadline)
+	} else {
+		conn.SetWriteDeadline(time.Now().Add(s.timeout))
+	}
+
+	if _, err := conn.Write(append(body, '\n')); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+	return nil
+}
